@@ -0,0 +1,70 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+// Numeric is a constraint that permits any numeric type that supports the
+// usual arithmetic operators. This includes time.Duration, since it is
+// defined as an int64 under the hood.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Approximately asserts that actual is within tolerance of expected, i.e.
+// that the absolute difference between the two does not exceed tolerance.
+func Approximately[T Numeric](t *testing.T, expected, actual, tolerance T) (ok bool) {
+	t.Helper()
+
+	var delta T
+	if actual > expected {
+		delta = actual - expected
+	} else {
+		delta = expected - actual
+	}
+
+	ok = delta <= tolerance
+	if !ok {
+		t.Errorf("%T = %v, want %v (tolerance %v, delta %v)", actual, actual, expected, tolerance, delta)
+	}
+
+	return ok
+}
+
+// ApproximatelyTime asserts that actual is within tolerance of expected,
+// i.e. that the absolute difference between the two times does not exceed
+// tolerance.
+func ApproximatelyTime(t *testing.T, expected, actual time.Time, tolerance time.Duration) (ok bool) {
+	t.Helper()
+
+	delta := actual.Sub(expected)
+	if delta < 0 {
+		delta = -delta
+	}
+
+	ok = delta <= tolerance
+	if !ok {
+		t.Errorf("%v = %v, want %v (tolerance %v, delta %v)", actual, actual, expected, tolerance, delta)
+	}
+
+	return ok
+}
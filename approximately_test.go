@@ -0,0 +1,90 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestApproximately(t *testing.T) {
+	type args struct {
+		t         *testing.T
+		expected  float64
+		actual    float64
+		tolerance float64
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "within tolerance",
+			args:   args{t: &testing.T{}, expected: 1.0, actual: 1.05, tolerance: 0.1},
+			wantOk: true,
+		},
+		{
+			name:   "outside tolerance",
+			args:   args{t: &testing.T{}, expected: 1.0, actual: 1.5, tolerance: 0.1},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Approximately(tt.args.t, tt.args.expected, tt.args.actual, tt.args.tolerance); gotOk != tt.wantOk {
+				t.Errorf("Approximately() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestApproximatelyTime(t *testing.T) {
+	now := time.Now()
+
+	type args struct {
+		t         *testing.T
+		expected  time.Time
+		actual    time.Time
+		tolerance time.Duration
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "within tolerance",
+			args:   args{t: &testing.T{}, expected: now, actual: now.Add(time.Second), tolerance: 2 * time.Second},
+			wantOk: true,
+		},
+		{
+			name:   "outside tolerance",
+			args:   args{t: &testing.T{}, expected: now, actual: now.Add(10 * time.Second), tolerance: 2 * time.Second},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := ApproximatelyTime(tt.args.t, tt.args.expected, tt.args.actual, tt.args.tolerance); gotOk != tt.wantOk {
+				t.Errorf("ApproximatelyTime() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
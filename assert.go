@@ -19,6 +19,7 @@ package assert
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -28,29 +29,62 @@ import (
 // together.
 type Want[T any] func(*testing.T, T) bool
 
+// Format controls how a failed comparison is rendered.
+type Format int
+
+const (
+	// FormatDiff renders a unified "-want +got" diff produced by
+	// [cmp.Diff]. This is the default.
+	FormatDiff Format = iota
+	// FormatValue renders the old "%v = ..., want %v" format, which is
+	// less noisy for simple value types.
+	FormatValue
+)
+
+// Formatter controls how [Equals] and [EqualsFunc] render a failure
+// message. It defaults to [FormatDiff].
+var Formatter = FormatDiff
+
 // Equals compares expected to actual and returns true if they are equal.
 func Equals[T any](t *testing.T, expected T, actual T, opts ...cmp.Option) (ok bool) {
 	t.Helper()
 
 	return EqualsFunc(t, expected, actual, func(expected T, actual T) bool {
 		return cmp.Equal(expected, actual, opts...)
-	})
+	}, opts...)
 }
 
 // Equals compares expected to actual using the equals function and returns true
 // if they are equal.
-func EqualsFunc[T any](t testing.TB, expected T, actual T, equals func(expected T, actual T) bool) (ok bool) {
+func EqualsFunc[T any](t testing.TB, expected T, actual T, equals func(expected T, actual T) bool, opts ...cmp.Option) (ok bool) {
 	t.Helper()
 
 	ok = equals(expected, actual)
 
 	if !ok {
-		t.Errorf("%T = %v, want %v", actual, actual, expected)
+		if Formatter == FormatValue {
+			t.Errorf("%T = %v, want %v", actual, actual, expected)
+		} else {
+			t.Errorf("mismatch (-want +got):\n%s", diff(expected, actual, opts...))
+		}
 	}
 
 	return ok
 }
 
+// diff renders the difference between expected and actual using [cmp.Diff].
+// If cmp.Diff panics, e.g. because of unexported fields it cannot compare,
+// it falls back to the plain "%v != %v" format instead.
+func diff[T any](expected T, actual T, opts ...cmp.Option) (s string) {
+	defer func() {
+		if r := recover(); r != nil {
+			s = fmt.Sprintf("%v != %v", actual, expected)
+		}
+	}()
+
+	return cmp.Diff(expected, actual, opts...)
+}
+
 // NotEquals compares expected to actual and returns true if they are not equal.
 func NotEquals[T any](t *testing.T, expected T, actual T, opts ...cmp.Option) (ok bool) {
 	t.Helper()
@@ -68,3 +68,18 @@ func TestEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestEqualsFormatter(t *testing.T) {
+	defer func() { Formatter = FormatDiff }()
+
+	for _, format := range []Format{FormatDiff, FormatValue} {
+		Formatter = format
+
+		if !Equals(&testing.T{}, &SomeStruct{A: 1, B: "foo"}, &SomeStruct{A: 1, B: "foo"}) {
+			t.Errorf("Equals() = false, want true for format %v", format)
+		}
+		if Equals(&testing.T{}, &SomeStruct{A: 1, B: "foo"}, &SomeStruct{A: 2, B: "bar"}) {
+			t.Errorf("Equals() = true, want false for format %v", format)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// Contains asserts that collection contains element.
+func Contains[T comparable](t *testing.T, collection []T, element T) (ok bool) {
+	t.Helper()
+
+	return ContainsFunc(t, collection, func(v T) bool {
+		return v == element
+	})
+}
+
+// ContainsFunc asserts that collection contains an element for which pred
+// returns true.
+func ContainsFunc[T any](t *testing.T, collection []T, pred func(T) bool) (ok bool) {
+	t.Helper()
+
+	for _, v := range collection {
+		if pred(v) {
+			return true
+		}
+	}
+
+	t.Errorf("%v does not contain the expected element", collection)
+
+	return false
+}
+
+// ContainsKey asserts that m contains key.
+func ContainsKey[K comparable, V any](t *testing.T, m map[K]V, key K) (ok bool) {
+	t.Helper()
+
+	_, ok = m[key]
+	if !ok {
+		t.Errorf("%v does not contain key %v", m, key)
+	}
+
+	return ok
+}
+
+// ContainsValue asserts that m contains value.
+func ContainsValue[K comparable, V comparable](t *testing.T, m map[K]V, value V) (ok bool) {
+	t.Helper()
+
+	for _, v := range m {
+		if v == value {
+			return true
+		}
+	}
+
+	t.Errorf("%v does not contain value %v", m, value)
+
+	return false
+}
+
+// Len asserts that collection has exactly n elements.
+func Len[T any](t *testing.T, collection []T, n int) (ok bool) {
+	t.Helper()
+
+	ok = len(collection) == n
+	if !ok {
+		t.Errorf("len(%v) = %v, want %v", collection, len(collection), n)
+	}
+
+	return ok
+}
+
+// Empty asserts that collection has no elements.
+func Empty[T any](t *testing.T, collection []T) (ok bool) {
+	t.Helper()
+
+	return Len(t, collection, 0)
+}
+
+// ElementsMatch asserts that expected and actual contain the same elements,
+// treating both as multisets so that order does not matter.
+func ElementsMatch[T any](t *testing.T, expected []T, actual []T, opts ...cmp.Option) (ok bool) {
+	t.Helper()
+
+	ok = elementsMatch(expected, actual, opts...)
+	if !ok {
+		t.Errorf("%v does not match %v (ignoring order)", actual, expected)
+	}
+
+	return ok
+}
+
+// elementsMatch reports whether expected and actual contain the same
+// elements as multisets, regardless of order.
+func elementsMatch[T any](expected []T, actual []T, opts ...cmp.Option) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	remaining := make([]T, len(actual))
+	copy(remaining, actual)
+
+	for _, e := range expected {
+		found := -1
+		for i, a := range remaining {
+			if cmp.Equal(e, a, opts...) {
+				found = i
+				break
+			}
+		}
+
+		if found == -1 {
+			return false
+		}
+
+		remaining = append(remaining[:found], remaining[found+1:]...)
+	}
+
+	return true
+}
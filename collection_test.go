@@ -0,0 +1,242 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import "testing"
+
+func TestContains(t *testing.T) {
+	type args struct {
+		t          *testing.T
+		collection []int
+		element    int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "present",
+			args:   args{t: &testing.T{}, collection: []int{1, 2, 3}, element: 2},
+			wantOk: true,
+		},
+		{
+			name:   "absent",
+			args:   args{t: &testing.T{}, collection: []int{1, 2, 3}, element: 4},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Contains(tt.args.t, tt.args.collection, tt.args.element); gotOk != tt.wantOk {
+				t.Errorf("Contains() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	type args struct {
+		t          *testing.T
+		collection []int
+		pred       func(int) bool
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "present",
+			args:   args{t: &testing.T{}, collection: []int{1, 2, 3}, pred: func(v int) bool { return v == 2 }},
+			wantOk: true,
+		},
+		{
+			name:   "absent",
+			args:   args{t: &testing.T{}, collection: []int{1, 2, 3}, pred: func(v int) bool { return v == 4 }},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := ContainsFunc(tt.args.t, tt.args.collection, tt.args.pred); gotOk != tt.wantOk {
+				t.Errorf("ContainsFunc() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestContainsKey(t *testing.T) {
+	type args struct {
+		t   *testing.T
+		m   map[string]int
+		key string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "present",
+			args:   args{t: &testing.T{}, m: map[string]int{"foo": 1}, key: "foo"},
+			wantOk: true,
+		},
+		{
+			name:   "absent",
+			args:   args{t: &testing.T{}, m: map[string]int{"foo": 1}, key: "bar"},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := ContainsKey(tt.args.t, tt.args.m, tt.args.key); gotOk != tt.wantOk {
+				t.Errorf("ContainsKey() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestContainsValue(t *testing.T) {
+	type args struct {
+		t     *testing.T
+		m     map[string]int
+		value int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "present",
+			args:   args{t: &testing.T{}, m: map[string]int{"foo": 1}, value: 1},
+			wantOk: true,
+		},
+		{
+			name:   "absent",
+			args:   args{t: &testing.T{}, m: map[string]int{"foo": 1}, value: 2},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := ContainsValue(tt.args.t, tt.args.m, tt.args.value); gotOk != tt.wantOk {
+				t.Errorf("ContainsValue() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestLen(t *testing.T) {
+	type args struct {
+		t          *testing.T
+		collection []int
+		n          int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "matches",
+			args:   args{t: &testing.T{}, collection: []int{1, 2, 3}, n: 3},
+			wantOk: true,
+		},
+		{
+			name:   "does not match",
+			args:   args{t: &testing.T{}, collection: []int{1, 2, 3}, n: 2},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Len(tt.args.t, tt.args.collection, tt.args.n); gotOk != tt.wantOk {
+				t.Errorf("Len() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	type args struct {
+		t          *testing.T
+		collection []int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "empty",
+			args:   args{t: &testing.T{}, collection: []int{}},
+			wantOk: true,
+		},
+		{
+			name:   "not empty",
+			args:   args{t: &testing.T{}, collection: []int{1}},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Empty(tt.args.t, tt.args.collection); gotOk != tt.wantOk {
+				t.Errorf("Empty() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestElementsMatch(t *testing.T) {
+	type args struct {
+		t        *testing.T
+		expected []int
+		actual   []int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "same elements, different order",
+			args:   args{t: &testing.T{}, expected: []int{1, 2, 3}, actual: []int{3, 2, 1}},
+			wantOk: true,
+		},
+		{
+			name:   "different multiset",
+			args:   args{t: &testing.T{}, expected: []int{1, 2, 3}, actual: []int{1, 2, 2}},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := ElementsMatch(tt.args.t, tt.args.expected, tt.args.actual); gotOk != tt.wantOk {
+				t.Errorf("ElementsMatch() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
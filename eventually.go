@@ -0,0 +1,62 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+// Eventually asserts that condition returns true within timeout, polling it
+// every interval.
+func Eventually(t *testing.T, condition func() bool, timeout time.Duration, interval time.Duration) (ok bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		if condition() {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			t.Errorf("condition was not met within %v", timeout)
+			return false
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Never asserts that condition does not return true within timeout, polling
+// it every interval.
+func Never(t *testing.T, condition func() bool, timeout time.Duration, interval time.Duration) (ok bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+
+	for time.Now().Before(deadline) {
+		if condition() {
+			t.Errorf("condition was met within %v, want it to never be met", timeout)
+			return false
+		}
+
+		time.Sleep(interval)
+	}
+
+	return true
+}
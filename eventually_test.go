@@ -0,0 +1,94 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventually(t *testing.T) {
+	type args struct {
+		t         *testing.T
+		condition func() bool
+		timeout   time.Duration
+		interval  time.Duration
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name: "becomes true in time",
+			args: args{t: &testing.T{}, condition: func() func() bool {
+				n := 0
+				return func() bool {
+					n++
+					return n >= 3
+				}
+			}(), timeout: 100 * time.Millisecond, interval: time.Millisecond},
+			wantOk: true,
+		},
+		{
+			name:   "never becomes true",
+			args:   args{t: &testing.T{}, condition: func() bool { return false }, timeout: 10 * time.Millisecond, interval: time.Millisecond},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Eventually(tt.args.t, tt.args.condition, tt.args.timeout, tt.args.interval); gotOk != tt.wantOk {
+				t.Errorf("Eventually() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestNever(t *testing.T) {
+	type args struct {
+		t         *testing.T
+		condition func() bool
+		timeout   time.Duration
+		interval  time.Duration
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "stays false",
+			args:   args{t: &testing.T{}, condition: func() bool { return false }, timeout: 10 * time.Millisecond, interval: time.Millisecond},
+			wantOk: true,
+		},
+		{
+			name:   "becomes true",
+			args:   args{t: &testing.T{}, condition: func() bool { return true }, timeout: 10 * time.Millisecond, interval: time.Millisecond},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Never(tt.args.t, tt.args.condition, tt.args.timeout, tt.args.interval); gotOk != tt.wantOk {
+				t.Errorf("Never() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
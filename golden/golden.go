@@ -0,0 +1,85 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+// package golden contains logic to assert test values against golden files
+// stored on disk, with support for updating them via the -update flag.
+package golden
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// update controls whether golden files are rewritten instead of compared
+// against. It is typically set via `go test -update`.
+var update = flag.Bool("update", false, "update golden files instead of comparing against them")
+
+// Golden asserts that actual matches the contents of the golden file at
+// path. If the test binary is run with -update, the golden file is
+// overwritten with actual instead.
+func Golden(t *testing.T, actual []byte, path string) (ok bool) {
+	t.Helper()
+
+	return golden(t, actual, path)
+}
+
+// GoldenString behaves like [Golden], but accepts actual as a string.
+func GoldenString(t *testing.T, actual string, path string) (ok bool) {
+	t.Helper()
+
+	return golden(t, []byte(actual), path)
+}
+
+func golden(t *testing.T, actual []byte, path string) (ok bool) {
+	t.Helper()
+
+	if *update {
+		if err := os.WriteFile(path, actual, 0644); err != nil {
+			t.Fatalf("failed to update golden file %s: %v", path, err)
+		}
+
+		return true
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file %s: %v", path, err)
+	}
+
+	ok = bytes.Equal(want, actual)
+	if !ok {
+		t.Errorf("golden file %s does not match (-want +got):\n%s", path, diff(want, actual))
+	}
+
+	return ok
+}
+
+// diff renders the difference between want and actual. If both are valid
+// UTF-8, it produces a line-oriented text diff; otherwise it falls back to
+// an element-wise byte diff, since coercing arbitrary binary data (images,
+// protobufs, ...) into a string produces unreadable output.
+func diff(want []byte, actual []byte) string {
+	if utf8.Valid(want) && utf8.Valid(actual) {
+		return cmp.Diff(string(want), string(actual))
+	}
+
+	return cmp.Diff(want, actual)
+}
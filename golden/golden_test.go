@@ -0,0 +1,95 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package golden
+
+import "testing"
+
+func TestGoldenString(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		actual string
+		path   string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "matches",
+			args:   args{t: &testing.T{}, actual: "hello, world\n", path: "testdata/hello.golden"},
+			wantOk: true,
+		},
+		{
+			name:   "does not match",
+			args:   args{t: &testing.T{}, actual: "goodbye, world\n", path: "testdata/hello.golden"},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := GoldenString(tt.args.t, tt.args.actual, tt.args.path); gotOk != tt.wantOk {
+				t.Errorf("GoldenString() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestGolden(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		actual []byte
+		path   string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "matches",
+			args:   args{t: &testing.T{}, actual: []byte("hello, world\n"), path: "testdata/hello.golden"},
+			wantOk: true,
+		},
+		{
+			name:   "does not match",
+			args:   args{t: &testing.T{}, actual: []byte("goodbye, world\n"), path: "testdata/hello.golden"},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Golden(tt.args.t, tt.args.actual, tt.args.path); gotOk != tt.wantOk {
+				t.Errorf("Golden() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+// TestGoldenBinary exercises the byte-diff fallback: a mismatch against
+// non-UTF-8 content goes through the byte diff and is reported as a failure.
+// The rendered diff itself is not inspected.
+func TestGoldenBinary(t *testing.T) {
+	if !Golden(&testing.T{}, []byte{'P', 'N', 'G', 0x01, 0x02, 0x03}, "testdata/binary.golden") {
+		t.Error("Golden() = false, want true")
+	}
+	if Golden(&testing.T{}, []byte{0xff, 0xfe}, "testdata/binary.golden") {
+		t.Error("Golden() = true, want false")
+	}
+}
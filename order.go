@@ -0,0 +1,83 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"cmp"
+	"testing"
+)
+
+// Greater asserts that actual is greater than low.
+func Greater[T cmp.Ordered](t *testing.T, low T, actual T) (ok bool) {
+	t.Helper()
+
+	ok = actual > low
+	if !ok {
+		t.Errorf("%v is not greater than %v", actual, low)
+	}
+
+	return ok
+}
+
+// Less asserts that actual is less than high.
+func Less[T cmp.Ordered](t *testing.T, high T, actual T) (ok bool) {
+	t.Helper()
+
+	ok = actual < high
+	if !ok {
+		t.Errorf("%v is not less than %v", actual, high)
+	}
+
+	return ok
+}
+
+// GreaterOrEqual asserts that actual is greater than or equal to low.
+func GreaterOrEqual[T cmp.Ordered](t *testing.T, low T, actual T) (ok bool) {
+	t.Helper()
+
+	ok = actual >= low
+	if !ok {
+		t.Errorf("%v is not greater than or equal to %v", actual, low)
+	}
+
+	return ok
+}
+
+// LessOrEqual asserts that actual is less than or equal to high.
+func LessOrEqual[T cmp.Ordered](t *testing.T, high T, actual T) (ok bool) {
+	t.Helper()
+
+	ok = actual <= high
+	if !ok {
+		t.Errorf("%v is not less than or equal to %v", actual, high)
+	}
+
+	return ok
+}
+
+// InRange asserts that actual lies within [low, high], inclusive on both
+// ends.
+func InRange[T cmp.Ordered](t *testing.T, low T, high T, actual T) (ok bool) {
+	t.Helper()
+
+	ok = actual >= low && actual <= high
+	if !ok {
+		t.Errorf("%v is not in range [%v, %v]", actual, low, high)
+	}
+
+	return ok
+}
@@ -0,0 +1,180 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import "testing"
+
+func TestGreater(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		low    int
+		actual int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "holds",
+			args:   args{t: &testing.T{}, low: 1, actual: 2},
+			wantOk: true,
+		},
+		{
+			name:   "fails",
+			args:   args{t: &testing.T{}, low: 2, actual: 1},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Greater(tt.args.t, tt.args.low, tt.args.actual); gotOk != tt.wantOk {
+				t.Errorf("Greater() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestLess(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		high   int
+		actual int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "holds",
+			args:   args{t: &testing.T{}, high: 2, actual: 1},
+			wantOk: true,
+		},
+		{
+			name:   "fails",
+			args:   args{t: &testing.T{}, high: 1, actual: 2},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := Less(tt.args.t, tt.args.high, tt.args.actual); gotOk != tt.wantOk {
+				t.Errorf("Less() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestGreaterOrEqual(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		low    int
+		actual int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "holds, equal",
+			args:   args{t: &testing.T{}, low: 1, actual: 1},
+			wantOk: true,
+		},
+		{
+			name:   "fails",
+			args:   args{t: &testing.T{}, low: 2, actual: 1},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := GreaterOrEqual(tt.args.t, tt.args.low, tt.args.actual); gotOk != tt.wantOk {
+				t.Errorf("GreaterOrEqual() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestLessOrEqual(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		high   int
+		actual int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "holds, equal",
+			args:   args{t: &testing.T{}, high: 1, actual: 1},
+			wantOk: true,
+		},
+		{
+			name:   "fails",
+			args:   args{t: &testing.T{}, high: 1, actual: 2},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := LessOrEqual(tt.args.t, tt.args.high, tt.args.actual); gotOk != tt.wantOk {
+				t.Errorf("LessOrEqual() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestInRange(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		low    int
+		high   int
+		actual int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "holds",
+			args:   args{t: &testing.T{}, low: 1, high: 10, actual: 5},
+			wantOk: true,
+		},
+		{
+			name:   "fails",
+			args:   args{t: &testing.T{}, low: 1, high: 10, actual: 11},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := InRange(tt.args.t, tt.args.low, tt.args.high, tt.args.actual); gotOk != tt.wantOk {
+				t.Errorf("InRange() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
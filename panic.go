@@ -0,0 +1,74 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import "testing"
+
+// Panics asserts that fn panics and returns the recovered value.
+func Panics(t *testing.T, fn func()) (recovered any) {
+	t.Helper()
+
+	func() {
+		defer func() {
+			recovered = recover()
+		}()
+
+		fn()
+	}()
+
+	if recovered == nil {
+		t.Errorf("fn did not panic")
+	}
+
+	return recovered
+}
+
+// PanicsWith asserts that fn panics with a value equal to expected.
+func PanicsWith[T any](t *testing.T, expected T, fn func()) (ok bool) {
+	t.Helper()
+
+	recovered := Panics(t, fn)
+	if recovered == nil {
+		return false
+	}
+
+	cast, isT := recovered.(T)
+	if !isT {
+		t.Errorf("fn panicked with %T, want %T", recovered, expected)
+		return false
+	}
+
+	return Equals(t, expected, cast)
+}
+
+// DoesNotPanic asserts that fn does not panic.
+func DoesNotPanic(t *testing.T, fn func()) (ok bool) {
+	t.Helper()
+
+	ok = true
+
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+			t.Errorf("fn panicked with %v", r)
+		}
+	}()
+
+	fn()
+
+	return ok
+}
@@ -0,0 +1,113 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import "testing"
+
+func TestPanics(t *testing.T) {
+	type args struct {
+		t  *testing.T
+		fn func()
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantRecovered any
+	}{
+		{
+			name:          "panics",
+			args:          args{t: &testing.T{}, fn: func() { panic("oops") }},
+			wantRecovered: "oops",
+		},
+		{
+			name:          "does not panic",
+			args:          args{t: &testing.T{}, fn: func() {}},
+			wantRecovered: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotRecovered := Panics(tt.args.t, tt.args.fn); gotRecovered != tt.wantRecovered {
+				t.Errorf("Panics() = %v, want %v", gotRecovered, tt.wantRecovered)
+			}
+		})
+	}
+}
+
+func TestPanicsWith(t *testing.T) {
+	type args struct {
+		t        *testing.T
+		expected string
+		fn       func()
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "matches",
+			args:   args{t: &testing.T{}, expected: "oops", fn: func() { panic("oops") }},
+			wantOk: true,
+		},
+		{
+			name:   "does not match",
+			args:   args{t: &testing.T{}, expected: "oops", fn: func() { panic("nope") }},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := PanicsWith(tt.args.t, tt.args.expected, tt.args.fn); gotOk != tt.wantOk {
+				t.Errorf("PanicsWith() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestDoesNotPanic(t *testing.T) {
+	type args struct {
+		t  *testing.T
+		fn func()
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "does not panic",
+			args:   args{t: &testing.T{}, fn: func() {}},
+			wantOk: true,
+		},
+		{
+			name:   "panics",
+			args:   args{t: &testing.T{}, fn: func() { panic("oops") }},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if gotOk := DoesNotPanic(tt.args.t, tt.args.fn); gotOk != tt.wantOk {
+				t.Errorf("DoesNotPanic() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
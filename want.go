@@ -0,0 +1,134 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// WantEquals returns a [Want] that asserts its argument equals expected,
+// using [Equals] semantics.
+func WantEquals[T any](expected T, opts ...cmp.Option) Want[T] {
+	return func(t *testing.T, actual T) bool {
+		t.Helper()
+
+		return Equals(t, expected, actual, opts...)
+	}
+}
+
+// WantErrorIs returns a [Want] that asserts its argument is target, using
+// [ErrorIs] semantics.
+func WantErrorIs(target error) Want[error] {
+	return func(t *testing.T, actual error) bool {
+		t.Helper()
+
+		return ErrorIs(t, target, actual)
+	}
+}
+
+// WantMatches returns a [Want] that asserts its argument matches the
+// regular expression re.
+func WantMatches(re string) Want[string] {
+	return func(t *testing.T, actual string) bool {
+		t.Helper()
+
+		matched, err := regexp.MatchString(re, actual)
+		if err != nil {
+			t.Fatalf("invalid regular expression %q: %v", re, err)
+		}
+
+		if !matched {
+			t.Errorf("%q does not match %q", actual, re)
+		}
+
+		return matched
+	}
+}
+
+// All returns a [Want] that asserts all of wants hold. Every want is
+// evaluated against t, so all failures are reported.
+func All[T any](wants ...Want[T]) Want[T] {
+	return func(t *testing.T, actual T) (ok bool) {
+		t.Helper()
+
+		ok = true
+		for _, want := range wants {
+			if !want(t, actual) {
+				ok = false
+			}
+		}
+
+		return ok
+	}
+}
+
+// Any returns a [Want] that asserts at least one of wants holds.
+func Any[T any](wants ...Want[T]) Want[T] {
+	return func(t *testing.T, actual T) bool {
+		t.Helper()
+
+		for _, want := range wants {
+			if probe(want, actual) {
+				return true
+			}
+		}
+
+		t.Errorf("%v did not match any of %d alternatives", actual, len(wants))
+
+		return false
+	}
+}
+
+// Not returns a [Want] that asserts want does not hold.
+func Not[T any](want Want[T]) Want[T] {
+	return func(t *testing.T, actual T) (ok bool) {
+		t.Helper()
+
+		ok = !probe(want, actual)
+		if !ok {
+			t.Errorf("%v unexpectedly matched", actual)
+		}
+
+		return ok
+	}
+}
+
+// probe evaluates want against actual without letting it affect the calling
+// test. A plain &testing.T{} is not enough for this: Fatalf/FailNow call
+// runtime.Goexit, which unwinds whatever goroutine called it, and Any/Not
+// call want synchronously, so that Goexit would abort the real test instead
+// of just the probe. Running want in its own goroutine confines a Goexit to
+// that goroutine; the result is reported via t2.Failed() so it is captured
+// regardless of whether want returns normally or exits via Goexit.
+func probe[T any](want Want[T], actual T) bool {
+	result := make(chan bool, 1)
+
+	go func() {
+		t2 := &testing.T{}
+		defer func() {
+			recover()
+			result <- !t2.Failed()
+		}()
+
+		want(t2, actual)
+	}()
+
+	return <-result
+}
@@ -0,0 +1,151 @@
+// Copyright 2023-2024 Christian Banse
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// This file is part of The Money Gopher.
+
+package assert
+
+import (
+	"errors"
+	"testing"
+)
+
+func double(n int) (int, error) {
+	if n < 0 {
+		return 0, errors.New("n must not be negative")
+	}
+
+	return n * 2, nil
+}
+
+func TestWantCombinators(t *testing.T) {
+	type args struct {
+		t    *testing.T
+		n    int
+		want Want[int]
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "WantEquals, matches",
+			args:   args{t: &testing.T{}, n: 2, want: WantEquals(4)},
+			wantOk: true,
+		},
+		{
+			name:   "WantEquals, does not match",
+			args:   args{t: &testing.T{}, n: 2, want: WantEquals(5)},
+			wantOk: false,
+		},
+		{
+			name:   "Any, one alternative matches",
+			args:   args{t: &testing.T{}, n: 3, want: Any(WantEquals(5), WantEquals(6))},
+			wantOk: true,
+		},
+		{
+			name:   "Any, no alternative matches",
+			args:   args{t: &testing.T{}, n: 3, want: Any(WantEquals(1), WantEquals(2))},
+			wantOk: false,
+		},
+		{
+			name:   "Not, inner fails so Not holds",
+			args:   args{t: &testing.T{}, n: 2, want: Not(WantEquals(0))},
+			wantOk: true,
+		},
+		{
+			name:   "Not, inner holds so Not fails",
+			args:   args{t: &testing.T{}, n: 2, want: Not(WantEquals(4))},
+			wantOk: false,
+		},
+		{
+			name:   "All, both hold",
+			args:   args{t: &testing.T{}, n: 2, want: All(WantEquals(4), Not(WantEquals(0)))},
+			wantOk: true,
+		},
+		{
+			name:   "All, one fails",
+			args:   args{t: &testing.T{}, n: 2, want: All(WantEquals(5), Not(WantEquals(0)))},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := double(tt.args.n)
+			if err != nil {
+				t.Fatalf("double() error = %v", err)
+			}
+
+			if gotOk := tt.args.want(tt.args.t, got); gotOk != tt.wantOk {
+				t.Errorf("want(%v) = %v, want %v", got, gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+// TestNotSurvivesFatalfBasedWant guards against Not/Any running a wrapped
+// want synchronously: WantMatches calls t.Fatalf on an invalid pattern,
+// which would otherwise abort this very test via runtime.Goexit instead of
+// just flipping the result.
+func TestNotSurvivesFatalfBasedWant(t *testing.T) {
+	want := Not(WantMatches("("))
+
+	if !want(&testing.T{}, "x") {
+		t.Error("Not(WantMatches(invalid pattern)) = false, want true")
+	}
+}
+
+func TestWantErrorIs(t *testing.T) {
+	want := WantErrorIs(nil)
+
+	_, err := double(1)
+	if !want(t, err) {
+		t.Errorf("WantErrorIs() did not match %v", err)
+	}
+}
+
+func TestWantMatches(t *testing.T) {
+	type args struct {
+		t      *testing.T
+		re     string
+		actual string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		wantOk bool
+	}{
+		{
+			name:   "matches",
+			args:   args{t: &testing.T{}, re: `^foo`, actual: "foobar"},
+			wantOk: true,
+		},
+		{
+			name:   "does not match",
+			args:   args{t: &testing.T{}, re: `^foo`, actual: "barfoo"},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := WantMatches(tt.args.re)
+			if gotOk := want(tt.args.t, tt.args.actual); gotOk != tt.wantOk {
+				t.Errorf("WantMatches() = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}